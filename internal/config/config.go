@@ -0,0 +1,260 @@
+// Package config loads server settings from an optional YAML/JSON file and
+// environment variable overrides, validating the result once up front so
+// every other package can accept a Config value instead of reading
+// os.Getenv directly.
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds every tunable the server needs to start.
+type Config struct {
+	Port               int
+	MongoURI           string
+	Database           string
+	Collection         string
+	ScrapeInterval     time.Duration
+	ScrapeBurst        int
+	UpdateInterval     time.Duration
+	CacheTTL           time.Duration
+	ShutdownTimeout    time.Duration
+	LogLevel           string
+	CORSAllowedOrigins []string
+}
+
+// fileConfig mirrors Config for the optional config file, using pointers
+// so an absent field doesn't clobber an env var or default.
+type fileConfig struct {
+	Port               *int     `json:"port" yaml:"port"`
+	MongoURI           *string  `json:"mongo_uri" yaml:"mongo_uri"`
+	Database           *string  `json:"database" yaml:"database"`
+	Collection         *string  `json:"collection" yaml:"collection"`
+	ScrapeInterval     *string  `json:"scrape_interval" yaml:"scrape_interval"`
+	ScrapeBurst        *int     `json:"scrape_burst" yaml:"scrape_burst"`
+	UpdateInterval     *string  `json:"update_interval" yaml:"update_interval"`
+	CacheTTL           *string  `json:"cache_ttl" yaml:"cache_ttl"`
+	ShutdownTimeout    *string  `json:"shutdown_timeout" yaml:"shutdown_timeout"`
+	LogLevel           *string  `json:"log_level" yaml:"log_level"`
+	CORSAllowedOrigins []string `json:"cors_allowed_origins" yaml:"cors_allowed_origins"`
+}
+
+// defaults returns a Config with every field set to its default value.
+func defaults() Config {
+	return Config{
+		Port:               8080,
+		Database:           "nba-data",
+		Collection:         "nba_seasons_v2",
+		ScrapeInterval:     2 * time.Second,
+		ScrapeBurst:        1,
+		UpdateInterval:     6 * time.Hour,
+		CacheTTL:           24 * time.Hour,
+		ShutdownTimeout:    15 * time.Second,
+		LogLevel:           "info",
+		CORSAllowedOrigins: []string{"*"},
+	}
+}
+
+var validLogLevels = map[string]bool{"debug": true, "info": true, "warn": true, "error": true}
+
+// Load builds a Config from, in increasing precedence: built-in defaults,
+// the optional file at configPath (YAML if the extension is .yml/.yaml,
+// JSON otherwise), and environment variables. configPath may be "" to skip
+// the file layer. It fails fast with a single error listing every
+// missing/invalid field rather than stopping at the first one.
+func Load(configPath string) (*Config, error) {
+	cfg := defaults()
+
+	var invalid []string
+
+	if configPath != "" {
+		if err := applyFile(&cfg, configPath, &invalid); err != nil {
+			return nil, err
+		}
+	}
+
+	applyEnv(&cfg, &invalid)
+
+	if cfg.MongoURI == "" {
+		invalid = append(invalid, "MONGODB_URI: required, not set in config file or environment")
+	}
+	if cfg.Port <= 0 {
+		invalid = append(invalid, fmt.Sprintf("port: must be positive, got %d", cfg.Port))
+	}
+	if cfg.ScrapeBurst <= 0 {
+		invalid = append(invalid, fmt.Sprintf("scrape_burst: must be positive, got %d", cfg.ScrapeBurst))
+	}
+	if cfg.ScrapeInterval <= 0 {
+		invalid = append(invalid, fmt.Sprintf("scrape_interval: must be positive, got %v", cfg.ScrapeInterval))
+	}
+	if cfg.UpdateInterval <= 0 {
+		invalid = append(invalid, fmt.Sprintf("update_interval: must be positive, got %v", cfg.UpdateInterval))
+	}
+	if !validLogLevels[cfg.LogLevel] {
+		invalid = append(invalid, fmt.Sprintf("log_level: must be one of debug/info/warn/error, got %q", cfg.LogLevel))
+	}
+	if len(cfg.CORSAllowedOrigins) == 0 {
+		invalid = append(invalid, "cors_allowed_origins: must contain at least one origin")
+	}
+
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("config: invalid configuration:\n  - %s", strings.Join(invalid, "\n  - "))
+	}
+
+	return &cfg, nil
+}
+
+// applyFile overlays the optional config file onto cfg. A missing file at
+// configPath is not an error; a present-but-unparseable file is. Invalid
+// duration fields are collected into invalid rather than silently ignored,
+// matching applyEnv.
+func applyFile(cfg *Config, configPath string, invalid *[]string) error {
+	data, err := os.ReadFile(configPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("config: reading %s: %w", configPath, err)
+	}
+
+	var fc fileConfig
+	if strings.HasSuffix(configPath, ".yml") || strings.HasSuffix(configPath, ".yaml") {
+		err = yaml.Unmarshal(data, &fc)
+	} else {
+		err = json.Unmarshal(data, &fc)
+	}
+	if err != nil {
+		return fmt.Errorf("config: parsing %s: %w", configPath, err)
+	}
+
+	if fc.Port != nil {
+		cfg.Port = *fc.Port
+	}
+	if fc.MongoURI != nil {
+		cfg.MongoURI = *fc.MongoURI
+	}
+	if fc.Database != nil {
+		cfg.Database = *fc.Database
+	}
+	if fc.Collection != nil {
+		cfg.Collection = *fc.Collection
+	}
+	if fc.ScrapeBurst != nil {
+		cfg.ScrapeBurst = *fc.ScrapeBurst
+	}
+	if fc.LogLevel != nil {
+		cfg.LogLevel = *fc.LogLevel
+	}
+	if len(fc.CORSAllowedOrigins) > 0 {
+		cfg.CORSAllowedOrigins = fc.CORSAllowedOrigins
+	}
+	if fc.ScrapeInterval != nil {
+		if d, err := time.ParseDuration(*fc.ScrapeInterval); err != nil {
+			*invalid = append(*invalid, fmt.Sprintf("scrape_interval: %v", err))
+		} else {
+			cfg.ScrapeInterval = d
+		}
+	}
+	if fc.UpdateInterval != nil {
+		if d, err := time.ParseDuration(*fc.UpdateInterval); err != nil {
+			*invalid = append(*invalid, fmt.Sprintf("update_interval: %v", err))
+		} else {
+			cfg.UpdateInterval = d
+		}
+	}
+	if fc.CacheTTL != nil {
+		if d, err := time.ParseDuration(*fc.CacheTTL); err != nil {
+			*invalid = append(*invalid, fmt.Sprintf("cache_ttl: %v", err))
+		} else {
+			cfg.CacheTTL = d
+		}
+	}
+	if fc.ShutdownTimeout != nil {
+		if d, err := time.ParseDuration(*fc.ShutdownTimeout); err != nil {
+			*invalid = append(*invalid, fmt.Sprintf("shutdown_timeout: %v", err))
+		} else {
+			cfg.ShutdownTimeout = d
+		}
+	}
+
+	return nil
+}
+
+// applyEnv overlays environment variables onto cfg, appending a
+// human-readable message to invalid for anything present but unparseable.
+func applyEnv(cfg *Config, invalid *[]string) {
+	if v := os.Getenv("SERVER_PORT"); v != "" {
+		port, err := strconv.Atoi(v)
+		if err != nil {
+			*invalid = append(*invalid, fmt.Sprintf("SERVER_PORT: %v", err))
+		} else {
+			cfg.Port = port
+		}
+	}
+	if v := os.Getenv("MONGODB_URI"); v != "" {
+		cfg.MongoURI = v
+	}
+	if v := os.Getenv("MONGO_DATABASE"); v != "" {
+		cfg.Database = v
+	}
+	if v := os.Getenv("MONGO_COLLECTION"); v != "" {
+		cfg.Collection = v
+	}
+	if v := os.Getenv("SCRAPER_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			*invalid = append(*invalid, fmt.Sprintf("SCRAPER_INTERVAL: %v", err))
+		} else {
+			cfg.ScrapeInterval = d
+		}
+	}
+	if v := os.Getenv("SCRAPER_BURST"); v != "" {
+		burst, err := strconv.Atoi(v)
+		if err != nil {
+			*invalid = append(*invalid, fmt.Sprintf("SCRAPER_BURST: %v", err))
+		} else {
+			cfg.ScrapeBurst = burst
+		}
+	}
+	if v := os.Getenv("UPDATER_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			*invalid = append(*invalid, fmt.Sprintf("UPDATER_INTERVAL: %v", err))
+		} else {
+			cfg.UpdateInterval = d
+		}
+	}
+	if v := os.Getenv("CACHE_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			*invalid = append(*invalid, fmt.Sprintf("CACHE_TTL: %v", err))
+		} else {
+			cfg.CacheTTL = d
+		}
+	}
+	if v := os.Getenv("SHUTDOWN_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			*invalid = append(*invalid, fmt.Sprintf("SHUTDOWN_TIMEOUT: %v", err))
+		} else {
+			cfg.ShutdownTimeout = d
+		}
+	}
+	if v := os.Getenv("LOG_LEVEL"); v != "" {
+		cfg.LogLevel = strings.ToLower(v)
+	}
+	if v := os.Getenv("CORS_ALLOWED_ORIGINS"); v != "" {
+		origins := strings.Split(v, ",")
+		for i := range origins {
+			origins[i] = strings.TrimSpace(origins[i])
+		}
+		cfg.CORSAllowedOrigins = origins
+	}
+}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func clearEnv(t *testing.T) {
+	t.Helper()
+	for _, key := range []string{
+		"SERVER_PORT", "MONGODB_URI", "MONGO_DATABASE", "MONGO_COLLECTION",
+		"SCRAPER_INTERVAL", "SCRAPER_BURST", "UPDATER_INTERVAL", "CACHE_TTL",
+		"SHUTDOWN_TIMEOUT", "LOG_LEVEL", "CORS_ALLOWED_ORIGINS",
+	} {
+		os.Unsetenv(key)
+	}
+}
+
+func TestLoad_MissingMongoURI(t *testing.T) {
+	clearEnv(t)
+
+	_, err := Load("")
+	if err == nil {
+		t.Fatal("expected an error when MONGODB_URI is unset")
+	}
+}
+
+func TestLoad_DefaultsWithEnvOverride(t *testing.T) {
+	clearEnv(t)
+	os.Setenv("MONGODB_URI", "mongodb://localhost:27017")
+	os.Setenv("SERVER_PORT", "9090")
+	defer clearEnv(t)
+
+	cfg, err := Load("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.Port != 9090 {
+		t.Errorf("expected port 9090, got %d", cfg.Port)
+	}
+	if cfg.Database != "nba-data" {
+		t.Errorf("expected default database, got %q", cfg.Database)
+	}
+	if cfg.ScrapeInterval != 2*time.Second {
+		t.Errorf("expected default scrape interval, got %v", cfg.ScrapeInterval)
+	}
+}
+
+func TestLoad_InvalidLogLevel(t *testing.T) {
+	clearEnv(t)
+	os.Setenv("MONGODB_URI", "mongodb://localhost:27017")
+	os.Setenv("LOG_LEVEL", "verbose")
+	defer clearEnv(t)
+
+	_, err := Load("")
+	if err == nil {
+		t.Fatal("expected an error for an invalid log level")
+	}
+}
+
+func TestLoad_NonPositiveUpdateInterval(t *testing.T) {
+	clearEnv(t)
+	os.Setenv("MONGODB_URI", "mongodb://localhost:27017")
+	os.Setenv("UPDATER_INTERVAL", "0s")
+	defer clearEnv(t)
+
+	_, err := Load("")
+	if err == nil {
+		t.Fatal("expected an error for a non-positive update interval")
+	}
+}
+
+func TestLoad_InvalidFileDuration(t *testing.T) {
+	clearEnv(t)
+	os.Setenv("MONGODB_URI", "mongodb://localhost:27017")
+	defer clearEnv(t)
+
+	dir := t.TempDir()
+	path := dir + "/config.json"
+	if err := os.WriteFile(path, []byte(`{"cache_ttl": "abc"}`), 0o644); err != nil {
+		t.Fatalf("writing config file: %v", err)
+	}
+
+	_, err := Load(path)
+	if err == nil {
+		t.Fatal("expected an error for an unparseable cache_ttl in the config file")
+	}
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/srinathv31/nba-data-api-go/internal/scraper"
+	"github.com/srinathv31/nba-data-api-go/internal/store"
+)
+
+// AdminRefreshHandler forces a re-scrape of a team/year, bypassing the TTL.
+type AdminRefreshHandler struct {
+	repo    store.TeamYearRepo
+	scraper *scraper.Scraper
+	ttl     time.Duration
+}
+
+// NewAdminRefreshHandler builds an AdminRefreshHandler backed by repo and
+// scraper. ttl is passed through to scraper.EnsureFresh; since the refresh
+// is always forced, it does not affect whether a scrape happens.
+func NewAdminRefreshHandler(repo store.TeamYearRepo, scr *scraper.Scraper, ttl time.Duration) *AdminRefreshHandler {
+	return &AdminRefreshHandler{repo: repo, scraper: scr, ttl: ttl}
+}
+
+// ServeHTTP handles POST /v1/admin/refresh/{team}/{year}.
+func (h *AdminRefreshHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	team := vars["team"]
+	yearStr := vars["year"]
+	year, err := strconv.Atoi(yearStr)
+	if err != nil {
+		http.Error(w, "Invalid year format", http.StatusBadRequest)
+		return
+	}
+
+	doc, err := scraper.EnsureFresh(r.Context(), h.repo, h.scraper, team, year, h.ttl, true)
+	if err != nil {
+		writeEnsureFreshError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(doc)
+}
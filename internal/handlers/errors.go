@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/srinathv31/nba-data-api-go/internal/scraper"
+)
+
+// writeEnsureFreshError reports an error from scraper.EnsureFresh with the
+// status code appropriate to its cause: a store failure is internal to us
+// (500), while a scrape failure is an upstream problem (502).
+func writeEnsureFreshError(w http.ResponseWriter, err error) {
+	var storeErr *scraper.StoreError
+	if errors.As(err, &storeErr) {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusBadGateway)
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Healthz handles GET /healthz, reporting only that the process is alive.
+func Healthz(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
+
+// ReadyzHandler handles GET /readyz, pinging Mongo to confirm the server
+// can actually serve requests.
+type ReadyzHandler struct {
+	client *mongo.Client
+}
+
+// NewReadyzHandler builds a ReadyzHandler backed by client.
+func NewReadyzHandler(client *mongo.Client) *ReadyzHandler {
+	return &ReadyzHandler{client: client}
+}
+
+// ServeHTTP pings Mongo and reports 200 if it responds, 503 otherwise.
+func (h *ReadyzHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := h.client.Ping(r.Context(), nil); err != nil {
+		http.Error(w, "mongo unavailable: "+err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
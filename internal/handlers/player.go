@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/srinathv31/nba-data-api-go/internal/store"
+)
+
+// PlayerHandler serves the player-centric endpoints: career-aggregated
+// stats and the per-team-per-year rows backing them.
+type PlayerHandler struct {
+	repo store.PlayerRepo
+}
+
+// NewPlayerHandler builds a PlayerHandler backed by repo.
+func NewPlayerHandler(repo store.PlayerRepo) *PlayerHandler {
+	return &PlayerHandler{repo: repo}
+}
+
+// seasonFilterFromQuery parses the shared ?from=YYYY&to=YYYY&team=BOS query
+// parameters used by both player endpoints.
+func seasonFilterFromQuery(r *http.Request) (store.SeasonFilter, error) {
+	var filter store.SeasonFilter
+
+	if from := r.URL.Query().Get("from"); from != "" {
+		year, err := strconv.Atoi(from)
+		if err != nil {
+			return filter, err
+		}
+		filter.FromYear = year
+	}
+
+	if to := r.URL.Query().Get("to"); to != "" {
+		year, err := strconv.Atoi(to)
+		if err != nil {
+			return filter, err
+		}
+		filter.ToYear = year
+	}
+
+	filter.Team = r.URL.Query().Get("team")
+
+	return filter, nil
+}
+
+// CareerStats handles GET /v1/nba/players/{name}.
+func (h *PlayerHandler) CareerStats(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+
+	filter, err := seasonFilterFromQuery(r)
+	if err != nil {
+		http.Error(w, "Invalid from/to year", http.StatusBadRequest)
+		return
+	}
+
+	stats, err := h.repo.CareerStats(r.Context(), name, filter)
+	if err != nil {
+		if err == store.ErrPlayerNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(stats)
+}
+
+// Seasons handles GET /v1/nba/players/{name}/seasons.
+func (h *PlayerHandler) Seasons(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+
+	filter, err := seasonFilterFromQuery(r)
+	if err != nil {
+		http.Error(w, "Invalid from/to year", http.StatusBadRequest)
+		return
+	}
+
+	seasons, err := h.repo.PlayerSeasons(r.Context(), name, filter)
+	if err != nil {
+		if err == store.ErrPlayerNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(seasons)
+}
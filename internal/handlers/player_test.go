@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/srinathv31/nba-data-api-go/internal/store"
+)
+
+type fakePlayerRepo struct {
+	career  *store.PlayerCareerStats
+	seasons []store.PlayerSeason
+	err     error
+}
+
+func (f *fakePlayerRepo) CareerStats(ctx context.Context, name string, filter store.SeasonFilter) (*store.PlayerCareerStats, error) {
+	return f.career, f.err
+}
+
+func (f *fakePlayerRepo) PlayerSeasons(ctx context.Context, name string, filter store.SeasonFilter) ([]store.PlayerSeason, error) {
+	return f.seasons, f.err
+}
+
+func TestPlayerHandler_CareerStats(t *testing.T) {
+	repo := &fakePlayerRepo{
+		career: &store.PlayerCareerStats{Name: "Jayson Tatum"},
+	}
+	handler := NewPlayerHandler(repo)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/v1/nba/players/{name}", handler.CareerStats).Methods("GET")
+
+	req := httptest.NewRequest("GET", "/v1/nba/players/Jayson%20Tatum?from=2020&to=2024&team=BOS", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+}
+
+func TestPlayerHandler_CareerStats_NotFound(t *testing.T) {
+	repo := &fakePlayerRepo{err: store.ErrPlayerNotFound}
+	handler := NewPlayerHandler(repo)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/v1/nba/players/{name}", handler.CareerStats).Methods("GET")
+
+	req := httptest.NewRequest("GET", "/v1/nba/players/Nobody", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d", rec.Code)
+	}
+}
+
+func TestPlayerHandler_Seasons(t *testing.T) {
+	repo := &fakePlayerRepo{
+		seasons: []store.PlayerSeason{{Team: "BOS", Year: 2024}},
+	}
+	handler := NewPlayerHandler(repo)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/v1/nba/players/{name}/seasons", handler.Seasons).Methods("GET")
+
+	req := httptest.NewRequest("GET", "/v1/nba/players/Jayson%20Tatum/seasons", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+}
+
+func TestPlayerHandler_InvalidYearQuery(t *testing.T) {
+	repo := &fakePlayerRepo{}
+	handler := NewPlayerHandler(repo)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/v1/nba/players/{name}", handler.CareerStats).Methods("GET")
+
+	req := httptest.NewRequest("GET", "/v1/nba/players/Jayson%20Tatum?from=not-a-year", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
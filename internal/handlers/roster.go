@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/srinathv31/nba-data-api-go/internal/scraper"
+	"github.com/srinathv31/nba-data-api-go/internal/store"
+)
+
+// RosterHandler serves the team/year roster endpoint, scraping and caching
+// basketball-reference data on a miss or stale read.
+type RosterHandler struct {
+	repo    store.TeamYearRepo
+	scraper *scraper.Scraper
+	ttl     time.Duration
+}
+
+// NewRosterHandler builds a RosterHandler backed by repo and scraper,
+// serving cached data for up to ttl before triggering a re-scrape.
+func NewRosterHandler(repo store.TeamYearRepo, scr *scraper.Scraper, ttl time.Duration) *RosterHandler {
+	return &RosterHandler{repo: repo, scraper: scr, ttl: ttl}
+}
+
+// ServeHTTP handles GET /v1/nba/{team}/{year}/roster.
+func (h *RosterHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	team := vars["team"]
+	yearStr := vars["year"]
+	year, err := strconv.Atoi(yearStr)
+	if err != nil {
+		http.Error(w, "Invalid year format", http.StatusBadRequest)
+		return
+	}
+
+	doc, err := scraper.EnsureFresh(r.Context(), h.repo, h.scraper, team, year, h.ttl, false)
+	if err != nil {
+		writeEnsureFreshError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(doc.Roster)
+}
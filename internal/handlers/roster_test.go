@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+func TestRosterHandler_StoreErrorIsInternal(t *testing.T) {
+	repo := &fakeTeamYearRepo{err: errors.New("connection refused")}
+	handler := NewRosterHandler(repo, nil, 24*time.Hour)
+
+	r := mux.NewRouter()
+	r.Handle("/v1/nba/{team}/{year}/roster", handler).Methods("GET")
+
+	req := httptest.NewRequest("GET", "/v1/nba/BOS/2024/roster", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status 500 for a store failure, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/srinathv31/nba-data-api-go/internal/scraper"
+	"github.com/srinathv31/nba-data-api-go/internal/store"
+)
+
+// ScheduleHandler serves the team/year schedule endpoint, scraping and
+// caching basketball-reference data on a miss or stale read.
+type ScheduleHandler struct {
+	repo    store.TeamYearRepo
+	scraper *scraper.Scraper
+	ttl     time.Duration
+}
+
+// NewScheduleHandler builds a ScheduleHandler backed by repo and scraper,
+// serving cached data for up to ttl before triggering a re-scrape.
+func NewScheduleHandler(repo store.TeamYearRepo, scr *scraper.Scraper, ttl time.Duration) *ScheduleHandler {
+	return &ScheduleHandler{repo: repo, scraper: scr, ttl: ttl}
+}
+
+// ServeHTTP handles GET /v1/nba/{team}/{year}/schedule.
+func (h *ScheduleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	team := vars["team"]
+	yearStr := vars["year"]
+	year, err := strconv.Atoi(yearStr)
+	if err != nil {
+		http.Error(w, "Invalid year format", http.StatusBadRequest)
+		return
+	}
+
+	doc, err := scraper.EnsureFresh(r.Context(), h.repo, h.scraper, team, year, h.ttl, false)
+	if err != nil {
+		writeEnsureFreshError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(doc.Schedule)
+}
@@ -0,0 +1,49 @@
+// Package handlers holds the HTTP handlers for the NBA data API. Each
+// handler type is constructed with the repository interfaces it needs so
+// it can be exercised in tests with a fake store.
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/srinathv31/nba-data-api-go/internal/store"
+)
+
+// TeamYearHandler serves the team/year endpoint.
+type TeamYearHandler struct {
+	repo store.TeamYearRepo
+}
+
+// NewTeamYearHandler builds a TeamYearHandler backed by the given repo.
+func NewTeamYearHandler(repo store.TeamYearRepo) *TeamYearHandler {
+	return &TeamYearHandler{repo: repo}
+}
+
+// ServeHTTP handles GET /v1/nba/{team}/{year}.
+func (h *TeamYearHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	team := vars["team"]
+	yearStr := vars["year"]
+	year, err := strconv.Atoi(yearStr)
+	if err != nil {
+		http.Error(w, "Invalid year format", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.repo.FindTeamYear(r.Context(), team, year)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	json.NewEncoder(w).Encode(result)
+}
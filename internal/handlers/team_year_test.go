@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/srinathv31/nba-data-api-go/internal/store"
+)
+
+// fakeTeamYearRepo is an in-memory store.TeamYearRepo for tests.
+type fakeTeamYearRepo struct {
+	result *store.TeamYear
+	err    error
+}
+
+func (f *fakeTeamYearRepo) FindTeamYear(ctx context.Context, team string, year int) (*store.TeamYear, error) {
+	return f.result, f.err
+}
+
+func (f *fakeTeamYearRepo) UpsertTeamYear(ctx context.Context, doc *store.TeamYear) error {
+	return nil
+}
+
+func (f *fakeTeamYearRepo) ListTeamYears(ctx context.Context) ([]store.TeamYearKey, error) {
+	return nil, nil
+}
+
+func TestTeamYearHandler_ServeHTTP(t *testing.T) {
+	repo := &fakeTeamYearRepo{
+		result: &store.TeamYear{Team: "BOS", Year: 2024, FullName: "Boston Celtics"},
+	}
+	handler := NewTeamYearHandler(repo)
+
+	r := mux.NewRouter()
+	r.Handle("/v1/nba/{team}/{year}", handler).Methods("GET")
+
+	req := httptest.NewRequest("GET", "/v1/nba/BOS/2024", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+}
+
+func TestTeamYearHandler_NotFound(t *testing.T) {
+	repo := &fakeTeamYearRepo{err: store.ErrNotFound}
+	handler := NewTeamYearHandler(repo)
+
+	r := mux.NewRouter()
+	r.Handle("/v1/nba/{team}/{year}", handler).Methods("GET")
+
+	req := httptest.NewRequest("GET", "/v1/nba/BOS/2024", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d", rec.Code)
+	}
+}
+
+func TestTeamYearHandler_StoreError(t *testing.T) {
+	repo := &fakeTeamYearRepo{err: errors.New("connection refused")}
+	handler := NewTeamYearHandler(repo)
+
+	r := mux.NewRouter()
+	r.Handle("/v1/nba/{team}/{year}", handler).Methods("GET")
+
+	req := httptest.NewRequest("GET", "/v1/nba/BOS/2024", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status 500 for a store failure, got %d", rec.Code)
+	}
+}
+
+func TestTeamYearHandler_InvalidYear(t *testing.T) {
+	repo := &fakeTeamYearRepo{}
+	handler := NewTeamYearHandler(repo)
+
+	r := mux.NewRouter()
+	r.Handle("/v1/nba/{team}/{year}", handler).Methods("GET")
+
+	req := httptest.NewRequest("GET", "/v1/nba/BOS/not-a-year", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", rec.Code)
+	}
+}
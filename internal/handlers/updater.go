@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/srinathv31/nba-data-api-go/internal/updater"
+)
+
+// UpdaterHandler exposes start/stop/status control over the background
+// updater.
+type UpdaterHandler struct {
+	updater *updater.Updater
+}
+
+// NewUpdaterHandler builds an UpdaterHandler backed by u.
+func NewUpdaterHandler(u *updater.Updater) *UpdaterHandler {
+	return &UpdaterHandler{updater: u}
+}
+
+// updaterStatusResponse is the JSON body returned by all three updater
+// control endpoints.
+type updaterStatusResponse struct {
+	Status string `json:"status"`
+}
+
+// Start handles POST /v1/admin/updater/start.
+func (h *UpdaterHandler) Start(w http.ResponseWriter, r *http.Request) {
+	h.updater.Start()
+	json.NewEncoder(w).Encode(updaterStatusResponse{Status: string(h.updater.Status())})
+}
+
+// Stop handles POST /v1/admin/updater/stop.
+func (h *UpdaterHandler) Stop(w http.ResponseWriter, r *http.Request) {
+	h.updater.Stop()
+	json.NewEncoder(w).Encode(updaterStatusResponse{Status: string(h.updater.Status())})
+}
+
+// Status handles GET /v1/admin/updater/status.
+func (h *UpdaterHandler) Status(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(updaterStatusResponse{Status: string(h.updater.Status())})
+}
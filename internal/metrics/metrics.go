@@ -0,0 +1,39 @@
+// Package metrics holds the Prometheus collectors shared across the
+// server, store, and scraper packages.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// RequestsTotal counts HTTP requests by method, route template, and
+	// response status.
+	RequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "nba_api_requests_total",
+		Help: "Total HTTP requests by method, path, and status.",
+	}, []string{"method", "path", "status"})
+
+	// RequestDuration observes HTTP request latency by method and route
+	// template.
+	RequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "nba_api_request_duration_seconds",
+		Help:    "HTTP request latency in seconds.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "path"})
+
+	// MongoQueryDuration observes Mongo query latency by operation name.
+	MongoQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "nba_api_mongo_query_duration_seconds",
+		Help:    "Mongo query latency in seconds.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"op"})
+
+	// ScraperResultsTotal counts scraper fetch outcomes by result
+	// ("success" or "failure").
+	ScraperResultsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "nba_api_scraper_results_total",
+		Help: "Scraper fetch outcomes by result.",
+	}, []string{"result"})
+)
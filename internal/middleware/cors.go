@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+)
+
+// CORS allows the configured origins to make cross-origin requests,
+// echoing the matched origin back (or "*" if that's the configured
+// wildcard) and short-circuiting preflight OPTIONS requests.
+func CORS(allowedOrigins []string) func(http.Handler) http.Handler {
+	wildcard := slices.Contains(allowedOrigins, "*")
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if wildcard {
+				w.Header().Set("Access-Control-Allow-Origin", "*")
+			} else if origin != "" && slices.Contains(allowedOrigins, origin) {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				w.Header().Add("Vary", "Origin")
+			}
+
+			if r.Method == http.MethodOptions {
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
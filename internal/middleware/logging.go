@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// responseWriter is a custom http.ResponseWriter that captures the status code
+type responseWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func wrapResponseWriter(w http.ResponseWriter) *responseWriter {
+	return &responseWriter{ResponseWriter: w}
+}
+
+func (rw *responseWriter) Status() int {
+	return rw.status
+}
+
+func (rw *responseWriter) WriteHeader(code int) {
+	if !rw.wroteHeader {
+		rw.status = code
+		rw.ResponseWriter.WriteHeader(code)
+		rw.wroteHeader = true
+	}
+}
+
+// Logging logs a structured JSON entry for every request: method, path,
+// status, duration, request_id, remote_addr, and user_agent. It must be
+// registered after RequestID so the request ID is present in context.
+func Logging(logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			wrapped := wrapResponseWriter(w)
+
+			next.ServeHTTP(wrapped, r)
+
+			// Ensure status is set, default to 200 if not set
+			if wrapped.status == 0 {
+				wrapped.status = 200
+			}
+
+			level := slog.LevelInfo
+			if wrapped.status >= 400 {
+				level = slog.LevelError
+			}
+
+			logger.Log(r.Context(), level, "request",
+				"method", r.Method,
+				"path", r.URL.Path,
+				"status", wrapped.status,
+				"duration_ms", time.Since(start).Milliseconds(),
+				"request_id", RequestIDFromContext(r.Context()),
+				"remote_addr", r.RemoteAddr,
+				"user_agent", r.UserAgent(),
+			)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/srinathv31/nba-data-api-go/internal/metrics"
+)
+
+// unmatchedPath is the path label used when a request didn't match any
+// route (e.g. a 404), instead of the raw, attacker-controlled URL path —
+// which would blow up the metric's label cardinality.
+const unmatchedPath = "unmatched"
+
+// Metrics records request counts and latency histograms against the
+// matched route's path template, falling back to unmatchedPath when a
+// request didn't match any route (e.g. a 404).
+func Metrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		wrapped := wrapResponseWriter(w)
+
+		next.ServeHTTP(wrapped, r)
+
+		if wrapped.status == 0 {
+			wrapped.status = 200
+		}
+
+		path := unmatchedPath
+		if route := mux.CurrentRoute(r); route != nil {
+			if tmpl, err := route.GetPathTemplate(); err == nil {
+				path = tmpl
+			}
+		}
+
+		metrics.RequestsTotal.WithLabelValues(r.Method, path, strconv.Itoa(wrapped.status)).Inc()
+		metrics.RequestDuration.WithLabelValues(r.Method, path).Observe(time.Since(start).Seconds())
+	})
+}
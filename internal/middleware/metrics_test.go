@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/srinathv31/nba-data-api-go/internal/metrics"
+)
+
+func TestMetrics_UnmatchedRouteUsesSentinelPath(t *testing.T) {
+	handler := Metrics(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	req := httptest.NewRequest("GET", "/some/attacker-controlled/junk-path", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if got := testutil.ToFloat64(metrics.RequestsTotal.WithLabelValues("GET", unmatchedPath, "404")); got != 1 {
+		t.Fatalf("expected the unmatched-path counter to be incremented, got %v", got)
+	}
+	if got := testutil.ToFloat64(metrics.RequestsTotal.WithLabelValues("GET", req.URL.Path, "404")); got != 0 {
+		t.Fatalf("expected no counter recorded under the raw request path, got %v", got)
+	}
+}
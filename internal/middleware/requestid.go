@@ -0,0 +1,40 @@
+// Package middleware holds cross-cutting HTTP middleware shared by the
+// server: request ID propagation, structured request logging, request
+// metrics, and CORS.
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+type contextKey int
+
+const requestIDKey contextKey = iota
+
+// RequestIDHeader is the response header the generated request ID is
+// echoed back on.
+const RequestIDHeader = "X-Request-ID"
+
+// RequestID generates a UUID per request, injects it into the request
+// context, and echoes it back as a response header so callers (and
+// downstream Mongo/scraper logs) can correlate a single request end to end.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := uuid.NewString()
+
+		w.Header().Set(RequestIDHeader, id)
+		ctx := context.WithValue(r.Context(), requestIDKey, id)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// RequestIDFromContext returns the request ID stored by RequestID, or ""
+// if none is present.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
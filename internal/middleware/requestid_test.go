@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestID_SetsHeaderAndContext(t *testing.T) {
+	var idFromContext string
+
+	handler := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		idFromContext = RequestIDFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest("GET", "/", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	headerID := rec.Header().Get(RequestIDHeader)
+	if headerID == "" {
+		t.Fatal("expected X-Request-ID header to be set")
+	}
+	if idFromContext != headerID {
+		t.Fatalf("context id %q does not match header id %q", idFromContext, headerID)
+	}
+}
+
+func TestRequestIDFromContext_Empty(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	if got := RequestIDFromContext(req.Context()); got != "" {
+		t.Fatalf("expected empty request id, got %q", got)
+	}
+}
@@ -0,0 +1,64 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/srinathv31/nba-data-api-go/internal/store"
+)
+
+// StoreError wraps a failure from the backing TeamYearRepo, as opposed to a
+// failure scraping upstream data, so callers can tell an internal database
+// failure apart from an upstream scrape failure and report the right status
+// code.
+type StoreError struct {
+	Err error
+}
+
+func (e *StoreError) Error() string { return e.Err.Error() }
+
+func (e *StoreError) Unwrap() error { return e.Err }
+
+// EnsureFresh returns the cached TeamYear document for team/year if it's
+// within ttl, otherwise it scrapes a fresh copy, persists it, and returns
+// that instead. Passing force=true always re-scrapes.
+func EnsureFresh(ctx context.Context, repo store.TeamYearRepo, scr *Scraper, team string, year int, ttl time.Duration, force bool) (*store.TeamYear, error) {
+	existing, err := repo.FindTeamYear(ctx, team, year)
+	if err != nil && err != store.ErrNotFound {
+		return nil, &StoreError{Err: fmt.Errorf("looking up existing team year: %w", err)}
+	}
+
+	if !force && existing != nil && existing.IsFresh(ttl) {
+		return existing, nil
+	}
+
+	roster, err := scr.ScrapeRoster(ctx, team, year)
+	if err != nil {
+		return nil, fmt.Errorf("refreshing roster: %w", err)
+	}
+
+	schedule, err := scr.ScrapeSchedule(ctx, team, year)
+	if err != nil {
+		return nil, fmt.Errorf("refreshing schedule: %w", err)
+	}
+
+	doc := &store.TeamYear{
+		Team:        team,
+		Year:        year,
+		RosterURL:   RosterURL(team, year),
+		Roster:      roster,
+		ScheduleURL: ScheduleURL(team, year),
+		Schedule:    schedule,
+		LastScraped: time.Now(),
+	}
+	if existing != nil {
+		doc.FullName = existing.FullName
+	}
+
+	if err := repo.UpsertTeamYear(ctx, doc); err != nil {
+		return nil, &StoreError{Err: fmt.Errorf("persisting refreshed team year: %w", err)}
+	}
+
+	return doc, nil
+}
@@ -0,0 +1,192 @@
+// Package scraper pulls roster and schedule data from basketball-reference
+// and parses it into the shapes store.TeamYear expects.
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/time/rate"
+
+	"github.com/srinathv31/nba-data-api-go/internal/metrics"
+	"github.com/srinathv31/nba-data-api-go/internal/middleware"
+	"github.com/srinathv31/nba-data-api-go/internal/store"
+)
+
+const userAgent = "nba-data-api-go/1.0 (+https://github.com/srinathv31/nba-data-api-go)"
+
+// Scraper fetches and parses basketball-reference team pages. It holds its
+// own rate limiter so callers don't need to coordinate request pacing.
+type Scraper struct {
+	httpClient *http.Client
+	limiter    *rate.Limiter
+	logger     *slog.Logger
+}
+
+// New builds a Scraper that allows at most one request every interval,
+// bursting up to burst requests. Scrape logs are tagged with the request
+// ID from ctx when one of the RequestID middleware put there.
+func New(interval time.Duration, burst int, logger *slog.Logger) *Scraper {
+	return &Scraper{
+		httpClient: &http.Client{Timeout: 15 * time.Second},
+		limiter:    rate.NewLimiter(rate.Every(interval), burst),
+		logger:     logger,
+	}
+}
+
+// RosterURL builds the basketball-reference URL for a team's roster page.
+func RosterURL(team string, year int) string {
+	return fmt.Sprintf("https://www.basketball-reference.com/teams/%s/%d.html", team, year)
+}
+
+// ScheduleURL builds the basketball-reference URL for a team's schedule
+// page.
+func ScheduleURL(team string, year int) string {
+	return fmt.Sprintf("https://www.basketball-reference.com/teams/%s/%d_games.html", team, year)
+}
+
+// get fetches url, respecting the scraper's rate limit and retrying once
+// with backoff on a 429/503 response.
+func (s *Scraper) get(ctx context.Context, url string) (*goquery.Document, error) {
+	if err := s.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "scraper: fetching", "url", url, "request_id", middleware.RequestIDFromContext(ctx))
+
+	resp, err := s.fetch(ctx, url)
+	if err != nil {
+		metrics.ScraperResultsTotal.WithLabelValues("failure").Inc()
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		resp.Body.Close()
+		backoff := time.Duration(2+rand.Intn(3)) * time.Second
+		time.Sleep(backoff)
+
+		resp, err = s.fetch(ctx, url)
+		if err != nil {
+			metrics.ScraperResultsTotal.WithLabelValues("failure").Inc()
+			return nil, err
+		}
+		defer resp.Body.Close()
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		metrics.ScraperResultsTotal.WithLabelValues("failure").Inc()
+		return nil, fmt.Errorf("scraper: unexpected status %d fetching %s", resp.StatusCode, url)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		metrics.ScraperResultsTotal.WithLabelValues("failure").Inc()
+		return nil, err
+	}
+
+	metrics.ScraperResultsTotal.WithLabelValues("success").Inc()
+	return doc, nil
+}
+
+func (s *Scraper) fetch(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	return s.httpClient.Do(req)
+}
+
+// ScrapeRoster fetches and parses the roster table for the given team/year.
+func (s *Scraper) ScrapeRoster(ctx context.Context, team string, year int) ([]store.Player, error) {
+	doc, err := s.get(ctx, RosterURL(team, year))
+	if err != nil {
+		return nil, fmt.Errorf("scraper: roster %s/%d: %w", team, year, err)
+	}
+
+	var players []store.Player
+	doc.Find("#roster tbody tr").Each(func(_ int, row *goquery.Selection) {
+		name := strings.TrimSpace(row.Find("td[data-stat='player']").Text())
+		if name == "" {
+			return
+		}
+		players = append(players, store.Player{Name: name})
+	})
+
+	stats := parsePlayerStats(doc, "totals_stats")
+	playoffStats := parsePlayerStats(doc, "playoffs_totals_stats")
+	for i := range players {
+		if ps, ok := stats[players[i].Name]; ok {
+			players[i].RegularSeason = ps
+		}
+		if ps, ok := playoffStats[players[i].Name]; ok {
+			players[i].Playoffs = ps
+		}
+	}
+
+	return players, nil
+}
+
+// playerStatLine mirrors the anonymous struct embedded in store.Player's
+// RegularSeason/Playoffs fields.
+type playerStatLine = struct {
+	G   string `json:"G" bson:"G"`
+	PER string `json:"PER" bson:"PER"`
+	TSP string `json:"TS%" bson:"TS%"`
+	WS  string `json:"WS" bson:"WS"`
+}
+
+func parsePlayerStats(doc *goquery.Document, tableID string) map[string]playerStatLine {
+	stats := make(map[string]playerStatLine)
+
+	doc.Find("#" + tableID + " tbody tr").Each(func(_ int, row *goquery.Selection) {
+		name := strings.TrimSpace(row.Find("td[data-stat='player']").Text())
+		if name == "" {
+			return
+		}
+		stats[name] = playerStatLine{
+			G:   strings.TrimSpace(row.Find("td[data-stat='g']").Text()),
+			PER: strings.TrimSpace(row.Find("td[data-stat='per']").Text()),
+			TSP: strings.TrimSpace(row.Find("td[data-stat='ts_pct']").Text()),
+			WS:  strings.TrimSpace(row.Find("td[data-stat='ws']").Text()),
+		}
+	})
+
+	return stats
+}
+
+// ScrapeSchedule fetches and parses the games table for the given
+// team/year.
+func (s *Scraper) ScrapeSchedule(ctx context.Context, team string, year int) ([]store.ScheduleGame, error) {
+	doc, err := s.get(ctx, ScheduleURL(team, year))
+	if err != nil {
+		return nil, fmt.Errorf("scraper: schedule %s/%d: %w", team, year, err)
+	}
+
+	var games []store.ScheduleGame
+	doc.Find("#games tbody tr").Each(func(_ int, row *goquery.Selection) {
+		date := strings.TrimSpace(row.Find("td[data-stat='date_game']").Text())
+		if date == "" {
+			return
+		}
+
+		games = append(games, store.ScheduleGame{
+			Date:     date,
+			Opponent: strings.TrimSpace(row.Find("td[data-stat='opp_name']").Text()),
+			Home:     strings.TrimSpace(row.Find("td[data-stat='game_location']").Text()) != "@",
+			Result:   strings.TrimSpace(row.Find("td[data-stat='game_result']").Text()),
+			TeamPts:  strings.TrimSpace(row.Find("td[data-stat='pts']").Text()),
+			OppPts:   strings.TrimSpace(row.Find("td[data-stat='opp_pts']").Text()),
+		})
+	})
+
+	return games, nil
+}
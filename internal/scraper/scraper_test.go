@@ -0,0 +1,48 @@
+package scraper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const sampleTotalsTable = `
+<table id="totals_stats">
+  <tbody>
+    <tr>
+      <td data-stat="player">Jayson Tatum</td>
+      <td data-stat="g">74</td>
+      <td data-stat="per">23.8</td>
+      <td data-stat="ts_pct">.589</td>
+      <td data-stat="ws">11.5</td>
+    </tr>
+  </tbody>
+</table>
+`
+
+func TestParsePlayerStats(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(sampleTotalsTable))
+	if err != nil {
+		t.Fatalf("unexpected error parsing fixture: %v", err)
+	}
+
+	stats := parsePlayerStats(doc, "totals_stats")
+
+	ps, ok := stats["Jayson Tatum"]
+	if !ok {
+		t.Fatalf("expected stats for Jayson Tatum, got %v", stats)
+	}
+	if ps.G != "74" || ps.PER != "23.8" || ps.TSP != ".589" || ps.WS != "11.5" {
+		t.Fatalf("unexpected stat line: %+v", ps)
+	}
+}
+
+func TestRosterURLAndScheduleURL(t *testing.T) {
+	if got, want := RosterURL("BOS", 2024), "https://www.basketball-reference.com/teams/BOS/2024.html"; got != want {
+		t.Fatalf("RosterURL() = %q, want %q", got, want)
+	}
+	if got, want := ScheduleURL("BOS", 2024), "https://www.basketball-reference.com/teams/BOS/2024_games.html"; got != want {
+		t.Fatalf("ScheduleURL() = %q, want %q", got, want)
+	}
+}
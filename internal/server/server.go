@@ -0,0 +1,122 @@
+// Package server wires together the router, handlers, and their
+// dependencies into a runnable Server.
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	"github.com/srinathv31/nba-data-api-go/internal/config"
+	"github.com/srinathv31/nba-data-api-go/internal/handlers"
+	"github.com/srinathv31/nba-data-api-go/internal/middleware"
+	"github.com/srinathv31/nba-data-api-go/internal/scraper"
+	"github.com/srinathv31/nba-data-api-go/internal/store"
+	"github.com/srinathv31/nba-data-api-go/internal/updater"
+)
+
+// logLevels maps a config.Config LogLevel string to its slog.Level.
+var logLevels = map[string]slog.Level{
+	"debug": slog.LevelDebug,
+	"info":  slog.LevelInfo,
+	"warn":  slog.LevelWarn,
+	"error": slog.LevelError,
+}
+
+// Server wires the router and its dependencies together.
+type Server struct {
+	Router *mux.Router
+
+	corsAllowedOrigins []string
+
+	teamYearRepo store.TeamYearRepo
+	playerRepo   store.PlayerRepo
+	scraper      *scraper.Scraper
+	updater      *updater.Updater
+	mongoClient  *mongo.Client
+}
+
+// New builds a Server from cfg and the given Mongo client, registering all
+// routes.
+func New(client *mongo.Client, cfg config.Config) *Server {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: logLevels[cfg.LogLevel],
+	}))
+
+	repo := store.NewMongoTeamYearRepo(client, cfg.Database, cfg.Collection, logger)
+	scr := scraper.New(cfg.ScrapeInterval, cfg.ScrapeBurst, logger)
+
+	indexCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := repo.EnsurePlayerNameIndex(indexCtx); err != nil {
+		logger.Error("server: ensuring roster.name index", "error", err)
+	}
+
+	s := &Server{
+		Router:             mux.NewRouter(),
+		corsAllowedOrigins: cfg.CORSAllowedOrigins,
+		teamYearRepo:       repo,
+		playerRepo:         repo,
+		scraper:            scr,
+		updater:            updater.New(repo, scr, cfg.UpdateInterval, cfg.CacheTTL, logger),
+		mongoClient:        client,
+	}
+
+	s.routes(cfg, logger)
+
+	return s
+}
+
+// Close stops the background updater. It should be called once during
+// process shutdown, before disconnecting the Mongo client.
+func (s *Server) Close() {
+	s.updater.Close()
+}
+
+// Handler returns the server's router wrapped with CORS. CORS must sit
+// outside the router rather than behind mux's Use middleware: a browser
+// preflight OPTIONS request doesn't match any route's .Methods("GET"/"POST")
+// matcher, so mux routes it to its method-not-allowed path, which never
+// invokes Use middleware.
+func (s *Server) Handler() http.Handler {
+	return middleware.CORS(s.corsAllowedOrigins)(s.Router)
+}
+
+// routes registers all HTTP routes on the server's router.
+func (s *Server) routes(cfg config.Config, logger *slog.Logger) {
+	s.Router.Use(middleware.RequestID)
+	s.Router.Use(middleware.Logging(logger))
+	s.Router.Use(middleware.Metrics)
+
+	s.Router.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "Welcome to the NBA Data API!")
+	})
+
+	s.Router.HandleFunc("/healthz", handlers.Healthz).Methods("GET")
+	s.Router.Handle("/readyz", handlers.NewReadyzHandler(s.mongoClient)).Methods("GET")
+	s.Router.Handle("/metrics", promhttp.Handler()).Methods("GET")
+
+	// Registered before /v1/nba/{team}/{year} so "players" isn't swallowed
+	// as a team code by the more generic route.
+	playerHandler := handlers.NewPlayerHandler(s.playerRepo)
+	s.Router.HandleFunc("/v1/nba/players/{name}", playerHandler.CareerStats).Methods("GET")
+	s.Router.HandleFunc("/v1/nba/players/{name}/seasons", playerHandler.Seasons).Methods("GET")
+
+	s.Router.Handle("/v1/nba/{team}/{year}", handlers.NewTeamYearHandler(s.teamYearRepo)).Methods("GET")
+	s.Router.Handle("/v1/nba/{team}/{year}/roster", handlers.NewRosterHandler(s.teamYearRepo, s.scraper, cfg.CacheTTL)).Methods("GET")
+	s.Router.Handle("/v1/nba/{team}/{year}/schedule", handlers.NewScheduleHandler(s.teamYearRepo, s.scraper, cfg.CacheTTL)).Methods("GET")
+	s.Router.Handle("/v1/admin/refresh/{team}/{year}", handlers.NewAdminRefreshHandler(s.teamYearRepo, s.scraper, cfg.CacheTTL)).Methods("POST")
+
+	updaterHandler := handlers.NewUpdaterHandler(s.updater)
+	s.Router.HandleFunc("/v1/admin/updater/start", updaterHandler.Start).Methods("POST")
+	s.Router.HandleFunc("/v1/admin/updater/stop", updaterHandler.Stop).Methods("POST")
+	s.Router.HandleFunc("/v1/admin/updater/status", updaterHandler.Status).Methods("GET")
+}
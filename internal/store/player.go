@@ -0,0 +1,226 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// SeasonFilter narrows a player query to a year range and/or a single team.
+// A zero FromYear/ToYear means no bound in that direction; an empty Team
+// means no team filter.
+type SeasonFilter struct {
+	FromYear int
+	ToYear   int
+	Team     string
+}
+
+// matchStage builds the $match stage document for the TeamYear-level
+// filters in f.
+func (f SeasonFilter) matchStage() bson.M {
+	match := bson.M{}
+
+	yearRange := bson.M{}
+	if f.FromYear != 0 {
+		yearRange["$gte"] = f.FromYear
+	}
+	if f.ToYear != 0 {
+		yearRange["$lte"] = f.ToYear
+	}
+	if len(yearRange) > 0 {
+		match["year"] = yearRange
+	}
+
+	if f.Team != "" {
+		match["team"] = f.Team
+	}
+
+	return match
+}
+
+// PlayerStatTotals holds the career regular-season or playoff line for a
+// player: counting stats summed across seasons, rate stats averaged.
+type PlayerStatTotals struct {
+	Games     float64 `json:"games" bson:"games"`
+	WinShares float64 `json:"win_shares" bson:"win_shares"`
+	AvgPER    float64 `json:"avg_per" bson:"avg_per"`
+	AvgTSP    float64 `json:"avg_ts_pct" bson:"avg_ts_pct"`
+}
+
+// PlayerCareerStats is the career-aggregated response for
+// /v1/nba/players/{name}.
+type PlayerCareerStats struct {
+	Name          string           `json:"name"`
+	RegularSeason PlayerStatTotals `json:"regular_season"`
+	Playoffs      PlayerStatTotals `json:"playoffs"`
+}
+
+// PlayerSeason is a single team/year row for a player, returned by
+// /v1/nba/players/{name}/seasons.
+type PlayerSeason struct {
+	Team   string `json:"team" bson:"team"`
+	Year   int    `json:"year" bson:"year"`
+	Player Player `json:"player" bson:"roster"`
+}
+
+// ErrPlayerNotFound is returned when no TeamYear document contains a
+// roster entry for the requested player.
+var ErrPlayerNotFound = mongo.ErrNoDocuments
+
+// PlayerRepo abstracts player-centric, cross-season lookups so handlers
+// can be tested against a fake implementation.
+type PlayerRepo interface {
+	CareerStats(ctx context.Context, name string, filter SeasonFilter) (*PlayerCareerStats, error)
+	PlayerSeasons(ctx context.Context, name string, filter SeasonFilter) ([]PlayerSeason, error)
+}
+
+// EnsurePlayerNameIndex creates the roster.name index the player
+// aggregation pipelines rely on. It's safe to call on every startup.
+func (r *MongoTeamYearRepo) EnsurePlayerNameIndex(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "roster.name", Value: 1}},
+	})
+	return err
+}
+
+// toDouble converts a stat field that's stored as a string (basketball-
+// reference leaves some cells blank) into a double for summing, treating
+// anything unparseable or missing as 0 so $sum isn't affected by seasons
+// the player didn't play (e.g. no playoff appearance).
+func toDouble(field string) bson.M {
+	return bson.M{
+		"$convert": bson.M{
+			"input":   field,
+			"to":      "double",
+			"onError": 0,
+			"onNull":  0,
+		},
+	}
+}
+
+// toDoubleOrRemove is like toDouble but drops the field entirely on a blank
+// or unparseable input instead of coercing to 0, so $avg only averages over
+// seasons that actually have the stat rather than being dragged down by
+// seasons the player didn't play (e.g. no playoff appearance).
+func toDoubleOrRemove(field string) bson.M {
+	return bson.M{
+		"$convert": bson.M{
+			"input":   field,
+			"to":      "double",
+			"onError": "$$REMOVE",
+			"onNull":  "$$REMOVE",
+		},
+	}
+}
+
+// CareerStats runs an aggregation pipeline that unwinds each TeamYear's
+// roster, matches the requested player, and sums/averages their regular
+// season and playoff lines across every season that matches filter.
+func (r *MongoTeamYearRepo) CareerStats(ctx context.Context, name string, filter SeasonFilter) (*PlayerCareerStats, error) {
+	start := time.Now()
+
+	pipeline := bson.A{
+		bson.M{"$match": filter.matchStage()},
+		bson.M{"$unwind": "$roster"},
+		bson.M{"$match": bson.M{"roster.name": name}},
+		bson.M{"$group": bson.M{
+			"_id":                nil,
+			"regular_games":      bson.M{"$sum": toDouble("$roster.regular_season.G")},
+			"regular_win_shares": bson.M{"$sum": toDouble("$roster.regular_season.WS")},
+			"regular_avg_per":    bson.M{"$avg": toDoubleOrRemove("$roster.regular_season.PER")},
+			"regular_avg_tsp":    bson.M{"$avg": toDoubleOrRemove("$roster.regular_season.TS%")},
+			"playoff_games":      bson.M{"$sum": toDouble("$roster.playoffs.G")},
+			"playoff_win_shares": bson.M{"$sum": toDouble("$roster.playoffs.WS")},
+			"playoff_avg_per":    bson.M{"$avg": toDoubleOrRemove("$roster.playoffs.PER")},
+			"playoff_avg_tsp":    bson.M{"$avg": toDoubleOrRemove("$roster.playoffs.TS%")},
+		}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logQuery(ctx, "CareerStats", start, err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var row struct {
+		RegularGames     float64 `bson:"regular_games"`
+		RegularWinShares float64 `bson:"regular_win_shares"`
+		RegularAvgPER    float64 `bson:"regular_avg_per"`
+		RegularAvgTSP    float64 `bson:"regular_avg_tsp"`
+		PlayoffGames     float64 `bson:"playoff_games"`
+		PlayoffWinShares float64 `bson:"playoff_win_shares"`
+		PlayoffAvgPER    float64 `bson:"playoff_avg_per"`
+		PlayoffAvgTSP    float64 `bson:"playoff_avg_tsp"`
+	}
+
+	if !cursor.Next(ctx) {
+		r.logQuery(ctx, "CareerStats", start, ErrPlayerNotFound)
+		return nil, ErrPlayerNotFound
+	}
+	if err := cursor.Decode(&row); err != nil {
+		r.logQuery(ctx, "CareerStats", start, err)
+		return nil, err
+	}
+
+	r.logQuery(ctx, "CareerStats", start, nil)
+
+	return &PlayerCareerStats{
+		Name: name,
+		RegularSeason: PlayerStatTotals{
+			Games:     row.RegularGames,
+			WinShares: row.RegularWinShares,
+			AvgPER:    row.RegularAvgPER,
+			AvgTSP:    row.RegularAvgTSP,
+		},
+		Playoffs: PlayerStatTotals{
+			Games:     row.PlayoffGames,
+			WinShares: row.PlayoffWinShares,
+			AvgPER:    row.PlayoffAvgPER,
+			AvgTSP:    row.PlayoffAvgTSP,
+		},
+	}, nil
+}
+
+// PlayerSeasons runs an aggregation pipeline that unwinds each TeamYear's
+// roster and returns one row per team/year the player appears in.
+func (r *MongoTeamYearRepo) PlayerSeasons(ctx context.Context, name string, filter SeasonFilter) ([]PlayerSeason, error) {
+	start := time.Now()
+
+	pipeline := bson.A{
+		bson.M{"$match": filter.matchStage()},
+		bson.M{"$unwind": "$roster"},
+		bson.M{"$match": bson.M{"roster.name": name}},
+		bson.M{"$project": bson.M{"team": 1, "year": 1, "roster": 1, "_id": 0}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logQuery(ctx, "PlayerSeasons", start, err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var seasons []PlayerSeason
+	for cursor.Next(ctx) {
+		var season PlayerSeason
+		if err := cursor.Decode(&season); err != nil {
+			r.logQuery(ctx, "PlayerSeasons", start, err)
+			return nil, err
+		}
+		seasons = append(seasons, season)
+	}
+
+	err = cursor.Err()
+	r.logQuery(ctx, "PlayerSeasons", start, err)
+	if err != nil {
+		return nil, err
+	}
+	if len(seasons) == 0 {
+		return nil, ErrPlayerNotFound
+	}
+
+	return seasons, nil
+}
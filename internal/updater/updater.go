@@ -0,0 +1,152 @@
+// Package updater periodically re-scrapes every known team/year so cached
+// data stays current without a request having to pay the scrape cost.
+package updater
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/srinathv31/nba-data-api-go/internal/scraper"
+	"github.com/srinathv31/nba-data-api-go/internal/store"
+)
+
+// Status describes whether the updater is currently ingesting on its
+// configured interval.
+type Status string
+
+const (
+	StatusRunning Status = "running"
+	StatusStopped Status = "stopped"
+)
+
+// Updater runs a background cycle runner that re-scrapes every (team, year)
+// pair on a fixed interval. Start and Stop pause and resume ingestion
+// in-place; Close tears down the background goroutine entirely.
+type Updater struct {
+	repo     store.TeamYearRepo
+	scraper  *scraper.Scraper
+	interval time.Duration
+	ttl      time.Duration
+	logger   *slog.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	run    chan struct{}
+
+	mu     sync.Mutex
+	status Status
+}
+
+// New builds an Updater and starts its background goroutine in the stopped
+// state; call Start to begin ingesting on the configured interval.
+func New(repo store.TeamYearRepo, scr *scraper.Scraper, interval, ttl time.Duration, logger *slog.Logger) *Updater {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	u := &Updater{
+		repo:     repo,
+		scraper:  scr,
+		interval: interval,
+		ttl:      ttl,
+		logger:   logger,
+		ctx:      ctx,
+		cancel:   cancel,
+		run:      make(chan struct{}, 1),
+		status:   StatusStopped,
+	}
+
+	go u.loop()
+
+	return u
+}
+
+// Start resumes ingestion, running a cycle immediately rather than waiting
+// for the next tick.
+func (u *Updater) Start() {
+	u.mu.Lock()
+	u.status = StatusRunning
+	u.mu.Unlock()
+
+	select {
+	case u.run <- struct{}{}:
+	default:
+	}
+}
+
+// Stop pauses ingestion without tearing down the background goroutine; a
+// later Start resumes on the same interval.
+func (u *Updater) Stop() {
+	u.mu.Lock()
+	u.status = StatusStopped
+	u.mu.Unlock()
+}
+
+// Status reports whether the updater is currently running or stopped.
+func (u *Updater) Status() Status {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.status
+}
+
+// Close stops the background goroutine for good. The Updater is not usable
+// afterward.
+func (u *Updater) Close() {
+	u.cancel()
+}
+
+func (u *Updater) isRunning() bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.status == StatusRunning
+}
+
+func (u *Updater) loop() {
+	ticker := time.NewTicker(u.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-u.ctx.Done():
+			return
+		case <-u.run:
+			u.runCycle()
+		case <-ticker.C:
+			if u.isRunning() {
+				u.runCycle()
+			}
+		}
+	}
+}
+
+// runCycle re-scrapes every known team/year, stopping early if the updater
+// is closed or stopped mid-cycle.
+func (u *Updater) runCycle() {
+	start := time.Now()
+
+	keys, err := u.repo.ListTeamYears(u.ctx)
+	if err != nil {
+		u.logger.Error("updater: listing team years", "error", err)
+		return
+	}
+
+	refreshed := 0
+	for _, key := range keys {
+		select {
+		case <-u.ctx.Done():
+			return
+		default:
+		}
+		if !u.isRunning() {
+			break
+		}
+
+		if _, err := scraper.EnsureFresh(u.ctx, u.repo, u.scraper, key.Team, key.Year, u.ttl, true); err != nil {
+			u.logger.Error("updater: refreshing team year", "team", key.Team, "year", key.Year, "error", err)
+			continue
+		}
+		refreshed++
+	}
+
+	u.logger.Info("updater: cycle complete", "refreshed", refreshed, "total", len(keys), "duration_ms", time.Since(start).Milliseconds())
+}